@@ -0,0 +1,16 @@
+package block
+
+import "github.com/vechain/thor/tx"
+
+// WarmAccessList aggregates the access lists declared across every tx in
+// txs, in tx then clause order. An executor running the block's txs
+// should mark every returned address and storage key warm before the
+// first clause touches it, mirroring the upfront charge each tx already
+// paid via Transaction.IntrinsicGas.
+func WarmAccessList(txs tx.Transactions) []tx.AccessTuple {
+	var list []tx.AccessTuple
+	for _, t := range txs {
+		list = append(list, t.AccessList()...)
+	}
+	return list
+}
@@ -0,0 +1,24 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestWarmAccessList(t *testing.T) {
+	al1 := []tx.AccessTuple{{Address: thor.Address{1}, StorageKeys: []thor.Hash{{1}}}}
+	al2 := []tx.AccessTuple{{Address: thor.Address{2}, StorageKeys: []thor.Hash{{2}, {3}}}}
+
+	t1 := new(tx.Builder).Clause(tx.NewClause(nil).WithAccessList(al1)).Gas(21000).Build()
+	t2 := new(tx.Builder).Clause(tx.NewClause(nil).WithAccessList(al2)).Gas(21000).Build()
+
+	got := WarmAccessList(tx.Transactions{t1, t2})
+	if len(got) != 2 {
+		t.Fatalf("len(WarmAccessList()) = %d, want 2", len(got))
+	}
+	if got[0].Address != al1[0].Address || got[1].Address != al2[0].Address {
+		t.Fatalf("WarmAccessList() = %v, want address order [%v, %v]", got, al1[0].Address, al2[0].Address)
+	}
+}
@@ -11,16 +11,18 @@ import (
 
 // Block is an immutable block type.
 type Block struct {
-	header *Header
-	txs    tx.Transactions
-	cache  struct {
+	header      *Header
+	txs         tx.Transactions
+	withdrawals Withdrawals
+	cache       struct {
 		size atomic.Value
 	}
 }
 
 // Body defines body of a block.
 type Body struct {
-	Txs tx.Transactions
+	Txs         tx.Transactions
+	Withdrawals Withdrawals
 }
 
 // Compose compose a block with all needed components
@@ -33,11 +35,22 @@ func Compose(header *Header, txs tx.Transactions) *Block {
 	}
 }
 
+// ComposeWithWithdrawals is like Compose, but also attaches the block's
+// withdrawal list. Note: the WithdrawalsRoot is not verified.
+func ComposeWithWithdrawals(header *Header, txs tx.Transactions, withdrawals Withdrawals) *Block {
+	return &Block{
+		header:      header,
+		txs:         append(tx.Transactions(nil), txs...),
+		withdrawals: append(Withdrawals(nil), withdrawals...),
+	}
+}
+
 // WithSignature create a new block object with signature set.
 func (b *Block) WithSignature(sig []byte) *Block {
 	return &Block{
-		header: b.header.withSignature(sig),
-		txs:    b.txs,
+		header:      b.header.withSignature(sig),
+		txs:         b.txs,
+		withdrawals: b.withdrawals,
 	}
 }
 
@@ -51,33 +64,105 @@ func (b *Block) Transactions() tx.Transactions {
 	return append(tx.Transactions(nil), b.txs...)
 }
 
+// Withdrawals returns a copy of the block's withdrawal list.
+func (b *Block) Withdrawals() Withdrawals {
+	return append(Withdrawals(nil), b.withdrawals...)
+}
+
 // Body returns body of a block.
 func (b *Block) Body() *Body {
-	return &Body{append(tx.Transactions(nil), b.txs...)}
+	return &Body{
+		Txs:         append(tx.Transactions(nil), b.txs...),
+		Withdrawals: append(Withdrawals(nil), b.withdrawals...),
+	}
+}
+
+// blockRLP is the canonical, block-inclusion RLP shape. Withdrawals is
+// optional so blocks produced before withdrawals existed still decode.
+type blockRLP struct {
+	Header      *Header
+	Txs         tx.Transactions
+	Withdrawals Withdrawals `rlp:"optional"`
 }
 
 // EncodeRLP implements rlp.Encoder.
 func (b *Block) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, []interface{}{
-		b.header,
-		b.txs,
+	return rlp.Encode(w, &blockRLP{
+		Header:      b.header,
+		Txs:         b.txs,
+		Withdrawals: b.withdrawals,
 	})
 }
 
 // DecodeRLP implements rlp.Decoder.
 func (b *Block) DecodeRLP(s *rlp.Stream) error {
-	payload := struct {
-		Header Header
-		Txs    tx.Transactions
-	}{}
+	var payload blockRLP
+	if err := s.Decode(&payload); err != nil {
+		return err
+	}
 
+	*b = Block{
+		header:      payload.Header,
+		txs:         payload.Txs,
+		withdrawals: payload.Withdrawals,
+	}
+	return nil
+}
+
+// networkBlockRLP is the gossip envelope: the same shape as blockRLP, plus
+// each tx's blob sidecar alongside it. A tx with no sidecar is carried as an
+// empty one, since rlp cannot decode a nil sidecar entry back.
+type networkBlockRLP struct {
+	Header      *Header
+	Txs         tx.Transactions
+	Withdrawals Withdrawals   `rlp:"optional"`
+	Sidecars    []*tx.Sidecar `rlp:"optional"`
+}
+
+// NetworkEncodeRLP encodes the block together with each tx's blob sidecar,
+// if it has one. It's the envelope used for gossip between nodes; the
+// canonical form used for block inclusion (EncodeRLP) never carries
+// sidecars.
+func (b *Block) NetworkEncodeRLP(w io.Writer) error {
+	sidecars := make([]*tx.Sidecar, len(b.txs))
+	for i, t := range b.txs {
+		if sc := t.Sidecar(); sc != nil {
+			sidecars[i] = sc
+		} else {
+			sidecars[i] = &tx.Sidecar{}
+		}
+	}
+	return rlp.Encode(w, &networkBlockRLP{
+		Header:      b.header,
+		Txs:         b.txs,
+		Withdrawals: b.withdrawals,
+		Sidecars:    sidecars,
+	})
+}
+
+// NetworkDecodeRLP decodes a block previously encoded with
+// NetworkEncodeRLP, re-attaching each tx's sidecar. An empty sidecar (no
+// blobs, commitments or proofs) is treated as none and not attached.
+func (b *Block) NetworkDecodeRLP(s *rlp.Stream) error {
+	var payload networkBlockRLP
 	if err := s.Decode(&payload); err != nil {
 		return err
 	}
 
+	txs := make(tx.Transactions, len(payload.Txs))
+	for i, t := range payload.Txs {
+		if i < len(payload.Sidecars) {
+			if sc := payload.Sidecars[i]; sc != nil && len(sc.Blobs) > 0 || len(sc.Commitments) > 0 || len(sc.Proofs) > 0 {
+				t = t.WithSidecar(sc)
+			}
+		}
+		txs[i] = t
+	}
+
 	*b = Block{
-		header: &payload.Header,
-		txs:    payload.Txs,
+		header:      payload.Header,
+		txs:         txs,
+		withdrawals: payload.Withdrawals,
 	}
 	return nil
 }
@@ -97,7 +182,8 @@ func (b *Block) Size() (size int) {
 func (b *Block) String() string {
 	return fmt.Sprintf(`Block(%v bytes)
 %v
-Transactions: %v`, b.Size(), b.header, b.txs)
+Transactions: %v
+Withdrawals: %v`, b.Size(), b.header, b.txs, b.withdrawals)
 }
 
 type counterWriter struct {
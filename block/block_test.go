@@ -0,0 +1,63 @@
+package block
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+func TestBlockWithWithdrawalsRLPRoundTrip(t *testing.T) {
+	header := new(Builder).
+		GasLimit(10_000_000).
+		WithdrawalsRoot(thor.Hash{1}).
+		Build().
+		Header()
+
+	withdrawals := Withdrawals{
+		{Index: 1, ValidatorID: thor.Address{2}, Recipient: thor.Address{3}, Amount: big.NewInt(100)},
+	}
+	orig := ComposeWithWithdrawals(header, nil, withdrawals)
+
+	data, err := rlp.EncodeToBytes(orig)
+	if err != nil {
+		t.Fatalf("EncodeToBytes() = %v", err)
+	}
+
+	var decoded Block
+	if err := rlp.DecodeBytes(data, &decoded); err != nil {
+		t.Fatalf("DecodeBytes() = %v", err)
+	}
+
+	got := decoded.Withdrawals()
+	if len(got) != 1 || got[0].Amount.Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("Withdrawals() = %v, want %v", got, withdrawals)
+	}
+}
+
+func TestBlockNetworkRLPRoundTrip(t *testing.T) {
+	header := new(Builder).GasLimit(10_000_000).Build().Header()
+
+	signed := new(tx.Builder).Clause(tx.NewClause(nil)).Gas(21000).Build().WithSignature([]byte{1})
+	orig := Compose(header, tx.Transactions{signed})
+
+	var buf bytes.Buffer
+	if err := orig.NetworkEncodeRLP(&buf); err != nil {
+		t.Fatalf("NetworkEncodeRLP() = %v", err)
+	}
+
+	var decoded Block
+	if err := decoded.NetworkDecodeRLP(rlp.NewStream(&buf, 0)); err != nil {
+		t.Fatalf("NetworkDecodeRLP() = %v", err)
+	}
+
+	if len(decoded.Transactions()) != 1 {
+		t.Fatalf("len(Transactions()) = %d, want 1", len(decoded.Transactions()))
+	}
+	if decoded.Transactions()[0].Hash() != signed.Hash() {
+		t.Fatal("decoded tx hash does not match original")
+	}
+}
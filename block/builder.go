@@ -0,0 +1,106 @@
+package block
+
+import (
+	"math/big"
+
+	"github.com/vechain/thor/thor"
+	"github.com/vechain/thor/tx"
+)
+
+// Builder to make it easy to build a block object.
+type Builder struct {
+	headerBody  headerBody
+	txs         tx.Transactions
+	withdrawals Withdrawals
+}
+
+// ParentID set parent id.
+func (b *Builder) ParentID(id thor.Hash) *Builder {
+	b.headerBody.ParentID = id
+	return b
+}
+
+// Timestamp set timestamp.
+func (b *Builder) Timestamp(ts uint64) *Builder {
+	b.headerBody.Timestamp = ts
+	return b
+}
+
+// GasLimit set gas limit.
+func (b *Builder) GasLimit(limit uint64) *Builder {
+	b.headerBody.GasLimit = limit
+	return b
+}
+
+// GasUsed set gas used.
+func (b *Builder) GasUsed(used uint64) *Builder {
+	b.headerBody.GasUsed = used
+	return b
+}
+
+// Beneficiary set reward recipient.
+func (b *Builder) Beneficiary(addr thor.Address) *Builder {
+	b.headerBody.Beneficiary = addr
+	return b
+}
+
+// TotalScore set total score.
+func (b *Builder) TotalScore(score uint64) *Builder {
+	b.headerBody.TotalScore = score
+	return b
+}
+
+// StateRoot set state root.
+func (b *Builder) StateRoot(root thor.Hash) *Builder {
+	b.headerBody.StateRoot = root
+	return b
+}
+
+// ReceiptsRoot set receipts root.
+func (b *Builder) ReceiptsRoot(root thor.Hash) *Builder {
+	b.headerBody.ReceiptsRoot = root
+	return b
+}
+
+// BaseFee set the block's base fee.
+func (b *Builder) BaseFee(fee *big.Int) *Builder {
+	b.headerBody.BaseFee = fee
+	return b
+}
+
+// Transaction appends a tx to the block being built. The TxsRoot is
+// computed separately and must be set explicitly.
+func (b *Builder) Transaction(t *tx.Transaction) *Builder {
+	b.txs = append(b.txs, t)
+	return b
+}
+
+// TxsRoot set txs root.
+func (b *Builder) TxsRoot(root thor.Hash) *Builder {
+	b.headerBody.TxsRoot = root
+	return b
+}
+
+// Withdrawal appends a withdrawal to the block being built. The
+// WithdrawalsRoot is computed separately and must be set explicitly.
+func (b *Builder) Withdrawal(w *Withdrawal) *Builder {
+	b.withdrawals = append(b.withdrawals, w)
+	return b
+}
+
+// WithdrawalsRoot set withdrawals root.
+func (b *Builder) WithdrawalsRoot(root thor.Hash) *Builder {
+	cpy := root
+	b.headerBody.WithdrawalsRoot = &cpy
+	return b
+}
+
+// Build builds a block object.
+func (b *Builder) Build() *Block {
+	header := Header{body: b.headerBody}
+	return &Block{
+		header:      &header,
+		txs:         append(tx.Transactions(nil), b.txs...),
+		withdrawals: append(Withdrawals(nil), b.withdrawals...),
+	}
+}
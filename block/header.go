@@ -0,0 +1,162 @@
+package block
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/cry"
+	"github.com/vechain/thor/thor"
+)
+
+// Header contains almost all information about a block, except block body.
+// It's immutable.
+type Header struct {
+	body headerBody
+}
+
+type headerBody struct {
+	ParentID     thor.Hash
+	Timestamp    uint64
+	GasLimit     uint64
+	GasUsed      uint64
+	Beneficiary  thor.Address
+	TotalScore   uint64
+	TxsRoot      thor.Hash
+	StateRoot    thor.Hash
+	ReceiptsRoot thor.Hash
+	Signature    []byte
+
+	// BaseFee is the per-block base fee a type-2 tx's MaxFeePerGas is
+	// checked against. Optional so that blocks produced before the fee
+	// market existed still decode.
+	BaseFee *big.Int `rlp:"optional"`
+
+	// WithdrawalsRoot is the merkle root of the block's withdrawal list.
+	// Optional so that blocks produced before withdrawals existed still
+	// decode.
+	WithdrawalsRoot *thor.Hash `rlp:"optional"`
+}
+
+// ParentID returns id of parent block.
+func (h *Header) ParentID() thor.Hash {
+	return h.body.ParentID
+}
+
+// Timestamp returns timestamp of block.
+func (h *Header) Timestamp() uint64 {
+	return h.body.Timestamp
+}
+
+// GasLimit returns gas limit of block.
+func (h *Header) GasLimit() uint64 {
+	return h.body.GasLimit
+}
+
+// GasUsed returns gas used by all txs in block.
+func (h *Header) GasUsed() uint64 {
+	return h.body.GasUsed
+}
+
+// Beneficiary returns reward recipient.
+func (h *Header) Beneficiary() thor.Address {
+	return h.body.Beneficiary
+}
+
+// TotalScore returns total score that cumulated from genesis block to this one.
+func (h *Header) TotalScore() uint64 {
+	return h.body.TotalScore
+}
+
+// TxsRoot returns merkle root of txs contained in block.
+func (h *Header) TxsRoot() thor.Hash {
+	return h.body.TxsRoot
+}
+
+// StateRoot returns account state merkle root just afert block being processed.
+func (h *Header) StateRoot() thor.Hash {
+	return h.body.StateRoot
+}
+
+// ReceiptsRoot returns merkle root of tx receipts.
+func (h *Header) ReceiptsRoot() thor.Hash {
+	return h.body.ReceiptsRoot
+}
+
+// BaseFee returns the block's base fee. It may be nil for a block produced
+// before the fee market was enabled.
+func (h *Header) BaseFee() *big.Int {
+	if h.body.BaseFee == nil {
+		return nil
+	}
+	return new(big.Int).Set(h.body.BaseFee)
+}
+
+// WithdrawalsRoot returns the merkle root of the block's withdrawal list.
+// It may be nil for a block produced before withdrawals were introduced.
+func (h *Header) WithdrawalsRoot() *thor.Hash {
+	if h.body.WithdrawalsRoot == nil {
+		return nil
+	}
+	root := *h.body.WithdrawalsRoot
+	return &root
+}
+
+// Signature returns signature.
+func (h *Header) Signature() []byte {
+	return append([]byte(nil), h.body.Signature...)
+}
+
+// SigningHash returns hash of the header excluding its signature.
+func (h *Header) SigningHash() (hash thor.Hash) {
+	hw := cry.NewHasher()
+	rlp.Encode(hw, []interface{}{
+		h.body.ParentID,
+		h.body.Timestamp,
+		h.body.GasLimit,
+		h.body.GasUsed,
+		h.body.Beneficiary,
+		h.body.TotalScore,
+		h.body.TxsRoot,
+		h.body.StateRoot,
+		h.body.ReceiptsRoot,
+		h.body.BaseFee,
+		h.body.WithdrawalsRoot,
+	})
+	hw.Sum(hash[:0])
+	return
+}
+
+// withSignature create a new Header object with signature set.
+func (h *Header) withSignature(sig []byte) *Header {
+	newHeader := Header{body: h.body}
+	newHeader.body.Signature = append([]byte(nil), sig...)
+	return &newHeader
+}
+
+// EncodeRLP implements rlp.Encoder.
+func (h *Header) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, &h.body)
+}
+
+// DecodeRLP implements rlp.Decoder.
+func (h *Header) DecodeRLP(s *rlp.Stream) error {
+	var body headerBody
+	if err := s.Decode(&body); err != nil {
+		return err
+	}
+	*h = Header{body: body}
+	return nil
+}
+
+func (h *Header) String() string {
+	return fmt.Sprintf(`Header(
+    ParentID:   %v
+    Timestamp:  %v
+    GasLimit:   %v
+    GasUsed:    %v
+    BaseFee:    %v
+)`,
+		h.body.ParentID, h.body.Timestamp, h.body.GasLimit, h.body.GasUsed, h.body.BaseFee)
+}
@@ -0,0 +1,62 @@
+package block
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// headerBody's optional fields (BaseFee, WithdrawalsRoot) must all come
+// after every required field, including Signature — go-ethereum's rlp
+// encoder rejects a struct where a required field follows an optional
+// one. BaseFee was originally placed before Signature; this guards
+// against that regression regardless of which required field it's
+// checked against.
+func TestHeaderRLPRoundTripWithSignature(t *testing.T) {
+	header := new(Builder).
+		GasLimit(10_000_000).
+		BaseFee(big.NewInt(50)).
+		Build().
+		Header().
+		withSignature([]byte{1, 2, 3})
+
+	data, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		t.Fatalf("EncodeToBytes() = %v", err)
+	}
+
+	var decoded Header
+	if err := rlp.DecodeBytes(data, &decoded); err != nil {
+		t.Fatalf("DecodeBytes() = %v", err)
+	}
+
+	if decoded.BaseFee().Cmp(big.NewInt(50)) != 0 {
+		t.Fatalf("BaseFee() = %v, want 50", decoded.BaseFee())
+	}
+	if string(decoded.Signature()) != string([]byte{1, 2, 3}) {
+		t.Fatalf("Signature() = %v, want [1 2 3]", decoded.Signature())
+	}
+}
+
+func TestHeaderRLPRoundTripNoBaseFee(t *testing.T) {
+	header := new(Builder).
+		GasLimit(10_000_000).
+		Build().
+		Header().
+		withSignature([]byte{1})
+
+	data, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		t.Fatalf("EncodeToBytes() = %v", err)
+	}
+
+	var decoded Header
+	if err := rlp.DecodeBytes(data, &decoded); err != nil {
+		t.Fatalf("DecodeBytes() = %v", err)
+	}
+
+	if decoded.BaseFee() != nil {
+		t.Fatalf("BaseFee() = %v, want nil", decoded.BaseFee())
+	}
+}
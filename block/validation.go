@@ -0,0 +1,50 @@
+package block
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/vechain/thor/tx"
+)
+
+// ValidateTransactionFees checks every tx's fee cap against the block's
+// base fee, rejecting any fee-market tx (dynamic-fee or blob) whose
+// MaxFeePerGas is below BaseFee. It returns the first violation found, or
+// nil if every tx clears its fee cap. Legacy txs have no fee cap and
+// always pass. A block with no BaseFee (produced before the fee market
+// existed) cannot legitimately carry a fee-market tx, so any such tx is
+// rejected outright rather than being compared against a nil base fee.
+func ValidateTransactionFees(header *Header, txs tx.Transactions) error {
+	baseFee := header.BaseFee()
+	for i, t := range txs {
+		if baseFee == nil {
+			if t.MaxFeePerGas() != nil {
+				return fmt.Errorf("tx %d: fee-market tx not allowed in a block with no base fee", i)
+			}
+			continue
+		}
+		if err := t.CheckFeeCap(baseFee); err != nil {
+			return fmt.Errorf("tx %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// TransactionFeeRefunds returns, for each tx in txs, the amount to refund
+// its payer given the gas it actually used: the difference between its
+// fee cap and the price actually charged against the block's base fee.
+// It's zero for txs with no fee cap, e.g. legacy, and zero for every tx
+// when the block has no BaseFee at all. gasUsed must hold one entry per
+// tx, in the same order.
+func TransactionFeeRefunds(header *Header, txs tx.Transactions, gasUsed []uint64) []*big.Int {
+	baseFee := header.BaseFee()
+	refunds := make([]*big.Int, len(txs))
+	for i, t := range txs {
+		if baseFee == nil {
+			refunds[i] = new(big.Int)
+			continue
+		}
+		refunds[i] = t.FeeCapRefund(baseFee, gasUsed[i])
+	}
+	return refunds
+}
@@ -0,0 +1,70 @@
+package block
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/vechain/thor/tx"
+)
+
+func newDynamicFeeTx(feeCap, tip int64) *tx.Transaction {
+	return new(tx.Builder).
+		Clause(tx.NewClause(nil)).
+		Gas(21000).
+		MaxFeePerGas(big.NewInt(feeCap)).
+		MaxPriorityFeePerGas(big.NewInt(tip)).
+		Build()
+}
+
+func TestValidateTransactionFees(t *testing.T) {
+	header := new(Builder).BaseFee(big.NewInt(50)).Build().Header()
+
+	ok := tx.Transactions{newDynamicFeeTx(100, 10)}
+	if err := ValidateTransactionFees(header, ok); err != nil {
+		t.Fatalf("ValidateTransactionFees() = %v, want nil", err)
+	}
+
+	tooLow := tx.Transactions{newDynamicFeeTx(100, 10), newDynamicFeeTx(40, 10)}
+	if err := ValidateTransactionFees(header, tooLow); err == nil {
+		t.Fatal("ValidateTransactionFees() = nil, want error for a fee cap below base fee")
+	}
+}
+
+func TestValidateTransactionFeesNilBaseFee(t *testing.T) {
+	header := new(Builder).Build().Header() // no BaseFee set
+
+	legacy := new(tx.Builder).Clause(tx.NewClause(nil)).Gas(21000).GasPrice(big.NewInt(1)).Build()
+	if err := ValidateTransactionFees(header, tx.Transactions{legacy}); err != nil {
+		t.Fatalf("ValidateTransactionFees() = %v, want nil for a legacy tx with no base fee", err)
+	}
+
+	feeMarket := tx.Transactions{newDynamicFeeTx(100, 10)}
+	if err := ValidateTransactionFees(header, feeMarket); err == nil {
+		t.Fatal("ValidateTransactionFees() = nil, want error for a fee-market tx in a block with no base fee")
+	}
+}
+
+func TestTransactionFeeRefundsNilBaseFee(t *testing.T) {
+	header := new(Builder).Build().Header() // no BaseFee set
+	txs := tx.Transactions{newDynamicFeeTx(100, 10)}
+
+	refunds := TransactionFeeRefunds(header, txs, []uint64{21000})
+	if len(refunds) != 1 || refunds[0].Sign() != 0 {
+		t.Fatalf("refunds = %v, want [0] when the block has no base fee", refunds)
+	}
+}
+
+func TestTransactionFeeRefunds(t *testing.T) {
+	header := new(Builder).BaseFee(big.NewInt(50)).Build().Header()
+	txs := tx.Transactions{newDynamicFeeTx(100, 10)}
+
+	refunds := TransactionFeeRefunds(header, txs, []uint64{21000})
+	if len(refunds) != 1 {
+		t.Fatalf("len(refunds) = %d, want 1", len(refunds))
+	}
+	// charged = min(100, 50+10) = 60, refund per unit gas = 100-60 = 40
+	want := big.NewInt(40 * 21000)
+	if refunds[0].Cmp(want) != 0 {
+		t.Fatalf("refunds[0] = %v, want %v", refunds[0], want)
+	}
+}
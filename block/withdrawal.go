@@ -0,0 +1,19 @@
+package block
+
+import (
+	"math/big"
+
+	"github.com/vechain/thor/thor"
+)
+
+// Withdrawal is a protocol-level payout, e.g. an authority-node reward or
+// a staking exit, applied outside the normal tx execution path.
+type Withdrawal struct {
+	Index       uint64
+	ValidatorID thor.Address
+	Recipient   thor.Address
+	Amount      *big.Int
+}
+
+// Withdrawals is a list of withdrawals carried by a block body.
+type Withdrawals []*Withdrawal
@@ -0,0 +1,22 @@
+// Package monotime exposes the Go runtime's monotonic clock directly,
+// bypassing the wall-clock component bundled into time.Now(). Callers that
+// only care about elapsed time (tx admission windows, mempool eviction,
+// replay-protection) should use it instead of time.Now(), so a wall-clock
+// jump or NTP step can't be mistaken for elapsed time. Modeled on Arista
+// Networks' monotime package.
+package monotime
+
+import (
+	_ "unsafe" // for go:linkname
+)
+
+//go:linkname nanotime runtime.nanotime
+func nanotime() int64
+
+// Now returns the current value of the runtime's monotonic clock, in
+// nanoseconds. The value has no meaning on its own and isn't comparable
+// across processes — only the difference between two calls to Now, within
+// the same process, is a meaningful duration.
+func Now() int64 {
+	return nanotime()
+}
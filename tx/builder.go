@@ -0,0 +1,139 @@
+package tx
+
+import (
+	"math/big"
+
+	"github.com/vechain/thor/thor"
+)
+
+// Builder to make it easy to build transaction.
+type Builder struct {
+	clauses     []*Clause
+	gas         uint64
+	nonce       uint64
+	timeBarrier uint64
+	expiration  uint64
+	dependsOn   *thor.Hash
+
+	gasPrice             *big.Int
+	maxFeePerGas         *big.Int
+	maxPriorityFeePerGas *big.Int
+	blob                 bool
+}
+
+// Clause add a clause.
+func (b *Builder) Clause(c *Clause) *Builder {
+	b.clauses = append(b.clauses, c)
+	return b
+}
+
+// Gas set gas provision.
+func (b *Builder) Gas(gas uint64) *Builder {
+	b.gas = gas
+	return b
+}
+
+// GasPrice set gas price, producing a legacy (type-0) tx.
+func (b *Builder) GasPrice(gasPrice *big.Int) *Builder {
+	b.gasPrice = gasPrice
+	return b
+}
+
+// MaxFeePerGas and MaxPriorityFeePerGas set the fee cap and priority tip,
+// producing a dynamic-fee (type-2) tx.
+func (b *Builder) MaxFeePerGas(feeCap *big.Int) *Builder {
+	b.maxFeePerGas = feeCap
+	return b
+}
+
+// MaxPriorityFeePerGas sets the priority tip cap of a dynamic-fee tx.
+func (b *Builder) MaxPriorityFeePerGas(tip *big.Int) *Builder {
+	b.maxPriorityFeePerGas = tip
+	return b
+}
+
+// BlobTx marks the transaction being built as a blob-carrying (type-3)
+// tx, producing a blobBody instead of a dynamicFeeBody. Use it together
+// with MaxFeePerGas/MaxPriorityFeePerGas and clauses built with
+// Clause.WithBlobHashes; attach the raw blob data afterwards with
+// Transaction.WithSidecar.
+func (b *Builder) BlobTx() *Builder {
+	b.blob = true
+	return b
+}
+
+// Nonce set nonce.
+func (b *Builder) Nonce(nonce uint64) *Builder {
+	b.nonce = nonce
+	return b
+}
+
+// TimeBarrier set time barrier.
+func (b *Builder) TimeBarrier(t uint64) *Builder {
+	b.timeBarrier = t
+	return b
+}
+
+// Expiration sets the number of seconds after TimeBarrier during which
+// the tx remains valid; 0 (the default) means it never expires.
+func (b *Builder) Expiration(seconds uint64) *Builder {
+	b.expiration = seconds
+	return b
+}
+
+// DependsOn set depending tx's hash.
+func (b *Builder) DependsOn(txHash *thor.Hash) *Builder {
+	if txHash == nil {
+		b.dependsOn = nil
+	} else {
+		cpy := *txHash
+		b.dependsOn = &cpy
+	}
+	return b
+}
+
+// Build build tx object.
+// It produces a blob (type-3) tx when BlobTx was called, a dynamic-fee
+// (type-2) tx when MaxFeePerGas/MaxPriorityFeePerGas were set, otherwise
+// a legacy (type-0) tx.
+func (b *Builder) Build() *Transaction {
+	if b.blob {
+		return &Transaction{
+			inner: &blobBody{
+				Clauses:              b.clauses,
+				MaxFeePerGas:         b.maxFeePerGas,
+				MaxPriorityFeePerGas: b.maxPriorityFeePerGas,
+				Gas:                  b.gas,
+				Nonce:                b.nonce,
+				TimeBarrier:          b.timeBarrier,
+				DependsOn:            b.dependsOn,
+				Expiration:           b.expiration,
+			},
+		}
+	}
+	if b.maxFeePerGas != nil || b.maxPriorityFeePerGas != nil {
+		return &Transaction{
+			inner: &dynamicFeeBody{
+				Clauses:              b.clauses,
+				MaxFeePerGas:         b.maxFeePerGas,
+				MaxPriorityFeePerGas: b.maxPriorityFeePerGas,
+				Gas:                  b.gas,
+				Nonce:                b.nonce,
+				TimeBarrier:          b.timeBarrier,
+				DependsOn:            b.dependsOn,
+				Expiration:           b.expiration,
+			},
+		}
+	}
+	return &Transaction{
+		inner: &legacyBody{
+			Clauses:     b.clauses,
+			GasPrice:    b.gasPrice,
+			Gas:         b.gas,
+			Nonce:       b.nonce,
+			TimeBarrier: b.timeBarrier,
+			DependsOn:   b.dependsOn,
+			Expiration:  b.expiration,
+		},
+	}
+}
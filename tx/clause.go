@@ -0,0 +1,123 @@
+package tx
+
+import (
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/thor"
+)
+
+// Clause is the basic unit to compose a transaction.
+type Clause struct {
+	body clauseBody
+}
+
+type clauseBody struct {
+	To    *thor.Address `rlp:"nil"`
+	Value *big.Int
+	Data  []byte
+
+	// BlobHashes holds the versioned hashes of blob-carrying data
+	// associated with this clause (see Sidecar). It's optional so
+	// existing signed clauses keep hashing identically.
+	BlobHashes []thor.Hash `rlp:"optional"`
+
+	// AccessList pre-declares addresses and storage slots this clause
+	// will touch, so the EVM executor can mark them "warm" up front in
+	// exchange for an upfront gas charge (see Transaction.IntrinsicGas).
+	// Optional so existing signed clauses keep hashing identically.
+	AccessList []AccessTuple `rlp:"optional"`
+}
+
+// AccessTuple pre-declares warm state a clause intends to touch,
+// mirroring EIP-2930: an address plus a set of storage slots within it.
+type AccessTuple struct {
+	Address     thor.Address
+	StorageKeys []thor.Hash
+}
+
+// NewClause create a new clause instance.
+func NewClause(to *thor.Address) *Clause {
+	return &Clause{
+		clauseBody{
+			To:    to,
+			Value: new(big.Int),
+		},
+	}
+}
+
+// WithValue create a new clause copy with value changed.
+func (c *Clause) WithValue(value *big.Int) *Clause {
+	newClause := *c
+	newClause.body.Value = new(big.Int).Set(value)
+	return &newClause
+}
+
+// WithData create a new clause copy with data changed.
+func (c *Clause) WithData(data []byte) *Clause {
+	newClause := *c
+	newClause.body.Data = append([]byte(nil), data...)
+	return &newClause
+}
+
+// WithBlobHashes create a new clause copy that declares the given blob
+// versioned hashes. The actual blob contents travel out-of-band in a
+// Sidecar attached to the owning Transaction.
+func (c *Clause) WithBlobHashes(hashes []thor.Hash) *Clause {
+	newClause := *c
+	newClause.body.BlobHashes = append([]thor.Hash(nil), hashes...)
+	return &newClause
+}
+
+// WithAccessList create a new clause copy that pre-declares the given
+// access list for warm-state gas accounting.
+func (c *Clause) WithAccessList(list []AccessTuple) *Clause {
+	newClause := *c
+	newClause.body.AccessList = append([]AccessTuple(nil), list...)
+	return &newClause
+}
+
+// To returns recipient address. nil means contract creation.
+func (c *Clause) To() *thor.Address {
+	if c.body.To == nil {
+		return nil
+	}
+	cpy := *c.body.To
+	return &cpy
+}
+
+// Value returns amount to be transferred with the clause.
+func (c *Clause) Value() *big.Int {
+	return new(big.Int).Set(c.body.Value)
+}
+
+// Data returns input data on the clause.
+func (c *Clause) Data() []byte {
+	return c.body.Data
+}
+
+// BlobHashes returns the blob versioned hashes declared by this clause.
+func (c *Clause) BlobHashes() []thor.Hash {
+	return append([]thor.Hash(nil), c.body.BlobHashes...)
+}
+
+// AccessList returns the access list declared by this clause.
+func (c *Clause) AccessList() []AccessTuple {
+	return append([]AccessTuple(nil), c.body.AccessList...)
+}
+
+// EncodeRLP implements rlp.Encoder.
+func (c *Clause) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, &c.body)
+}
+
+// DecodeRLP implements rlp.Decoder.
+func (c *Clause) DecodeRLP(s *rlp.Stream) error {
+	var body clauseBody
+	if err := s.Decode(&body); err != nil {
+		return err
+	}
+	*c = Clause{body}
+	return nil
+}
@@ -0,0 +1,53 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/thor"
+)
+
+func TestTransactionAccessListRLPRoundTrip(t *testing.T) {
+	list := []AccessTuple{
+		{Address: thor.Address{1}, StorageKeys: []thor.Hash{{1}, {2}}},
+	}
+	orig := new(Builder).Clause(NewClause(nil).WithAccessList(list)).Gas(21000).Build()
+
+	data, err := rlp.EncodeToBytes(orig)
+	if err != nil {
+		t.Fatalf("EncodeToBytes() = %v", err)
+	}
+
+	var decoded Transaction
+	if err := rlp.DecodeBytes(data, &decoded); err != nil {
+		t.Fatalf("DecodeBytes() = %v", err)
+	}
+
+	got := decoded.AccessList()
+	if len(got) != 1 || got[0].Address != list[0].Address || len(got[0].StorageKeys) != 2 {
+		t.Fatalf("AccessList() = %v, want %v", got, list)
+	}
+}
+
+func TestIntrinsicGasChargesAccessList(t *testing.T) {
+	bare := NewClause(nil)
+	withList := bare.WithAccessList([]AccessTuple{
+		{Address: thor.Address{1}, StorageKeys: []thor.Hash{{1}, {2}}},
+	})
+
+	txBare := new(Builder).Clause(bare).Gas(21000).Build()
+	txWithList := new(Builder).Clause(withList).Gas(21000).Build()
+
+	gasBare, err := txBare.IntrinsicGas()
+	if err != nil {
+		t.Fatalf("IntrinsicGas() = %v", err)
+	}
+	gasWithList, err := txWithList.IntrinsicGas()
+	if err != nil {
+		t.Fatalf("IntrinsicGas() = %v", err)
+	}
+
+	if gasWithList <= gasBare {
+		t.Fatalf("IntrinsicGas() with access list = %d, want more than bare %d", gasWithList, gasBare)
+	}
+}
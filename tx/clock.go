@@ -0,0 +1,31 @@
+package tx
+
+import (
+	"time"
+
+	"github.com/vechain/thor/internal/monotime"
+)
+
+// SeenAt returns the current reading of the monotonic clock. Mempool
+// implementations should stamp a tx with this when it's first admitted,
+// and use the difference against later readings to drive eviction and
+// replay-protection windows — never time.Now(), which can jump backwards
+// or forwards on a wall-clock adjustment.
+func SeenAt() int64 {
+	return monotime.Now()
+}
+
+// IsStale reports whether t has sat in the mempool past its Expiration
+// window, given seenAt (the monotonic reading, from SeenAt, taken when t
+// was first admitted). Unlike IsExpired, which checks Expiration against
+// the consensus block timestamp, IsStale is for local eviction decisions
+// and is immune to wall-clock jumps since it never touches time.Now().
+// A zero Expiration means t is never stale.
+func (t *Transaction) IsStale(seenAt int64) bool {
+	exp := t.inner.expiration()
+	if exp == 0 {
+		return false
+	}
+	elapsedSeconds := (monotime.Now() - seenAt) / int64(time.Second)
+	return elapsedSeconds > int64(exp)
+}
@@ -0,0 +1,61 @@
+package tx
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/vechain/thor/internal/monotime"
+)
+
+func newExpiringLegacyTx(timeBarrier, expiration uint64) *Transaction {
+	return new(Builder).
+		Clause(NewClause(nil)).
+		Gas(21000).
+		GasPrice(big.NewInt(0)).
+		TimeBarrier(timeBarrier).
+		Expiration(expiration).
+		Build()
+}
+
+func TestIsExpiredBoundary(t *testing.T) {
+	tx := newExpiringLegacyTx(100, 50)
+
+	if tx.IsExpired(150) {
+		t.Fatal("IsExpired(150) = true, want false exactly at the boundary")
+	}
+	if !tx.IsExpired(151) {
+		t.Fatal("IsExpired(151) = false, want true just past the boundary")
+	}
+}
+
+func TestIsExpiredNeverWhenZero(t *testing.T) {
+	tx := newExpiringLegacyTx(100, 0)
+
+	if tx.IsExpired(^uint64(0)) {
+		t.Fatal("IsExpired() = true, want false when Expiration is 0")
+	}
+}
+
+func TestIsStale(t *testing.T) {
+	tx := newExpiringLegacyTx(100, 1)
+
+	seenAt := SeenAt()
+	if tx.IsStale(seenAt) {
+		t.Fatal("IsStale() = true immediately after admission, want false")
+	}
+
+	// Fabricate a seenAt far enough in the past (in monotonic-clock terms)
+	// that the 1-second expiration window has elapsed.
+	past := seenAt - int64(2*1e9)
+	if !tx.IsStale(past) {
+		t.Fatal("IsStale() = false after the expiration window elapsed, want true")
+	}
+}
+
+func TestIsStaleNeverWhenZero(t *testing.T) {
+	tx := newExpiringLegacyTx(100, 0)
+
+	if tx.IsStale(monotime.Now() - int64(1e18)) {
+		t.Fatal("IsStale() = true, want false when Expiration is 0")
+	}
+}
@@ -0,0 +1,76 @@
+package tx
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func newDynamicFeeTx(feeCap, tip int64) *Transaction {
+	return new(Builder).
+		Clause(NewClause(nil)).
+		Gas(21000).
+		Nonce(1).
+		TimeBarrier(100).
+		MaxFeePerGas(big.NewInt(feeCap)).
+		MaxPriorityFeePerGas(big.NewInt(tip)).
+		Build().
+		WithSignature([]byte{1, 2, 3})
+}
+
+func TestDynamicFeeBodyRLPRoundTrip(t *testing.T) {
+	orig := newDynamicFeeTx(100, 10)
+
+	data, err := rlp.EncodeToBytes(orig)
+	if err != nil {
+		t.Fatalf("EncodeToBytes() = %v", err)
+	}
+
+	var decoded Transaction
+	if err := rlp.DecodeBytes(data, &decoded); err != nil {
+		t.Fatalf("DecodeBytes() = %v", err)
+	}
+
+	if decoded.Type() != DynamicFeeTxType {
+		t.Fatalf("Type() = %v, want %v", decoded.Type(), DynamicFeeTxType)
+	}
+	if got, want := decoded.MaxFeePerGas(), orig.MaxFeePerGas(); got.Cmp(want) != 0 {
+		t.Fatalf("MaxFeePerGas() = %v, want %v", got, want)
+	}
+	if decoded.Hash() != orig.Hash() {
+		t.Fatal("decoded tx hash does not match original")
+	}
+}
+
+func TestCheckFeeCap(t *testing.T) {
+	tx := newDynamicFeeTx(100, 10)
+
+	if err := tx.CheckFeeCap(big.NewInt(50)); err != nil {
+		t.Fatalf("CheckFeeCap(50) = %v, want nil", err)
+	}
+	if err := tx.CheckFeeCap(big.NewInt(100)); err != nil {
+		t.Fatalf("CheckFeeCap(100) = %v, want nil at the boundary", err)
+	}
+	if err := tx.CheckFeeCap(big.NewInt(101)); err == nil {
+		t.Fatal("CheckFeeCap(101) = nil, want error just above the boundary")
+	}
+}
+
+func TestLegacyBodyHasNoFeeCap(t *testing.T) {
+	legacy := new(Builder).
+		Clause(NewClause(nil)).
+		Gas(21000).
+		GasPrice(big.NewInt(100)).
+		Build()
+
+	if legacy.MaxFeePerGas() != nil {
+		t.Fatalf("MaxFeePerGas() = %v, want nil for a legacy tx", legacy.MaxFeePerGas())
+	}
+	if err := legacy.CheckFeeCap(big.NewInt(1000)); err != nil {
+		t.Fatalf("CheckFeeCap() = %v, want nil for a legacy tx regardless of base fee", err)
+	}
+	if refund := legacy.FeeCapRefund(big.NewInt(1000), 21000); refund.Sign() != 0 {
+		t.Fatalf("FeeCapRefund() = %v, want 0 for a legacy tx", refund)
+	}
+}
@@ -0,0 +1,53 @@
+package tx
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/vechain/thor/thor"
+)
+
+// Sidecar carries the raw blobs, KZG commitments and proofs backing a blob
+// tx's clauses. It travels alongside a Transaction but is never part of
+// its canonical, block-inclusion RLP: only the commitments' versioned
+// hashes, declared on the owning clauses, are committed to on-chain.
+type Sidecar struct {
+	Blobs       []kzg4844.Blob
+	Commitments []kzg4844.Commitment
+	Proofs      []kzg4844.Proof
+}
+
+// blobHashes derives the versioned hash of each commitment in the
+// sidecar, in order.
+func (s *Sidecar) blobHashes() []thor.Hash {
+	hashes := make([]thor.Hash, len(s.Commitments))
+	hasher := sha256.New()
+	for i, c := range s.Commitments {
+		hashes[i] = thor.Hash(kzg4844.CalcBlobHashV1(hasher, &c))
+	}
+	return hashes
+}
+
+// VerifyBlobs checks that every blob matches its commitment via the KZG
+// proof, and that the resulting versioned hashes match declaredHashes
+// (the hashes declared by the clauses that own this sidecar), in order.
+func (s *Sidecar) VerifyBlobs(declaredHashes []thor.Hash) error {
+	if len(s.Blobs) != len(s.Commitments) || len(s.Blobs) != len(s.Proofs) {
+		return fmt.Errorf("tx: sidecar blobs/commitments/proofs count mismatch")
+	}
+	if len(s.Blobs) != len(declaredHashes) {
+		return fmt.Errorf("tx: sidecar has %d blobs, clauses declare %d hashes", len(s.Blobs), len(declaredHashes))
+	}
+	for i := range s.Blobs {
+		if err := kzg4844.VerifyBlobProof(s.Blobs[i], s.Commitments[i], s.Proofs[i]); err != nil {
+			return fmt.Errorf("tx: blob %d failed proof verification: %w", i, err)
+		}
+	}
+	for i, got := range s.blobHashes() {
+		if got != declaredHashes[i] {
+			return fmt.Errorf("tx: blob %d versioned hash mismatch", i)
+		}
+	}
+	return nil
+}
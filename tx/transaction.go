@@ -1,7 +1,9 @@
 package tx
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"io"
 	"math/big"
 
@@ -12,19 +14,62 @@ import (
 	"github.com/vechain/thor/thor"
 )
 
+// Tx type identifiers. A legacy tx is encoded as a plain RLP list, exactly
+// as before; a typed tx is encoded as an RLP string holding [TypeByte,
+// payload...], following the EIP-2718 typed-envelope convention. This lets
+// new tx shapes be introduced without breaking the RLP of existing ones.
+const (
+	LegacyTxType     = 0
+	DynamicFeeTxType = 2
+	BlobTxType       = 3
+)
+
 // Transaction is an immutable tx type.
 type Transaction struct {
-	body body
+	inner   txData
+	sidecar *Sidecar
 
 	cache struct {
-		hash *thor.Hash
+		hash        *thor.Hash
+		signingHash *thor.Hash
 	}
 }
 
 var _ cry.Signable = (*Transaction)(nil)
 
-// body describes details of a tx.
-type body struct {
+// txData is implemented by every concrete tx body (legacyBody,
+// dynamicFeeBody, ...). Transaction dispatches to it based on the
+// envelope's leading type byte.
+type txData interface {
+	txType() byte
+
+	clauses() []*Clause
+	gas() uint64
+	nonce() uint64
+	timeBarrier() uint64
+	expiration() uint64
+	dependsOn() *thor.Hash
+	signature() []byte
+	withSignature(sig []byte) txData
+
+	// gasPrice returns the price actually paid per unit of gas, given the
+	// block base fee the tx is packed against. Legacy bodies ignore it.
+	gasPrice(baseFee *big.Int) *big.Int
+
+	// feeCap returns the fee cap (MaxFeePerGas) of a fee-market tx body,
+	// or nil for a body with no such concept, e.g. legacy.
+	feeCap() *big.Int
+
+	// priorityFeeCap returns the priority tip cap (MaxPriorityFeePerGas)
+	// of a fee-market tx body, or nil for a body with no such concept.
+	priorityFeeCap() *big.Int
+
+	encodePayload(w io.Writer) error
+}
+
+// legacyBody is the original, untyped tx body. Its RLP stays byte-for-byte
+// compatible with blocks produced before typed txs existed.
+type legacyBody struct {
 	Clauses     []*Clause
 	GasPrice    *big.Int
 	Gas         uint64
@@ -32,6 +77,140 @@ type body struct {
 	TimeBarrier uint64
 	DependsOn   *thor.Hash `rlp:"nil"`
 	Signature   []byte
+
+	// Expiration is the number of seconds after TimeBarrier during which
+	// the tx is valid; 0 means it never expires. Optional for back-compat
+	// with txs signed before expiration existed.
+	Expiration uint64 `rlp:"optional"`
+}
+
+func (b *legacyBody) txType() byte          { return LegacyTxType }
+func (b *legacyBody) clauses() []*Clause    { return b.Clauses }
+func (b *legacyBody) gas() uint64           { return b.Gas }
+func (b *legacyBody) nonce() uint64         { return b.Nonce }
+func (b *legacyBody) timeBarrier() uint64   { return b.TimeBarrier }
+func (b *legacyBody) expiration() uint64    { return b.Expiration }
+func (b *legacyBody) dependsOn() *thor.Hash { return b.DependsOn }
+func (b *legacyBody) signature() []byte     { return b.Signature }
+
+func (b *legacyBody) gasPrice(*big.Int) *big.Int {
+	return new(big.Int).Set(b.GasPrice)
+}
+
+func (b *legacyBody) feeCap() *big.Int         { return nil }
+func (b *legacyBody) priorityFeeCap() *big.Int { return nil }
+
+func (b *legacyBody) withSignature(sig []byte) txData {
+	cpy := *b
+	cpy.Signature = append([]byte(nil), sig...)
+	return &cpy
+}
+
+func (b *legacyBody) encodePayload(w io.Writer) error {
+	return rlp.Encode(w, b)
+}
+
+// dynamicFeeBody is a type-2 tx body modeled on EIP-1559: the payer names
+// a fee cap and a priority tip instead of a fixed GasPrice, and the price
+// actually charged is derived from the block's base fee.
+type dynamicFeeBody struct {
+	Clauses              []*Clause
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Gas                  uint64
+	Nonce                uint64
+	TimeBarrier          uint64
+	DependsOn            *thor.Hash `rlp:"nil"`
+	Signature            []byte
+
+	// Expiration is the number of seconds after TimeBarrier during which
+	// the tx is valid; 0 means it never expires. Optional for back-compat.
+	Expiration uint64 `rlp:"optional"`
+}
+
+func (b *dynamicFeeBody) txType() byte          { return DynamicFeeTxType }
+func (b *dynamicFeeBody) clauses() []*Clause    { return b.Clauses }
+func (b *dynamicFeeBody) gas() uint64           { return b.Gas }
+func (b *dynamicFeeBody) nonce() uint64         { return b.Nonce }
+func (b *dynamicFeeBody) timeBarrier() uint64   { return b.TimeBarrier }
+func (b *dynamicFeeBody) expiration() uint64    { return b.Expiration }
+func (b *dynamicFeeBody) dependsOn() *thor.Hash { return b.DependsOn }
+func (b *dynamicFeeBody) signature() []byte     { return b.Signature }
+
+// gasPrice returns baseFee plus as much of the priority tip as the fee cap
+// allows, i.e. min(MaxFeePerGas, baseFee+MaxPriorityFeePerGas).
+func (b *dynamicFeeBody) gasPrice(baseFee *big.Int) *big.Int {
+	tip := new(big.Int).Sub(b.MaxFeePerGas, baseFee)
+	if tip.Cmp(b.MaxPriorityFeePerGas) > 0 {
+		tip = b.MaxPriorityFeePerGas
+	}
+	return new(big.Int).Add(baseFee, tip)
+}
+
+func (b *dynamicFeeBody) feeCap() *big.Int         { return new(big.Int).Set(b.MaxFeePerGas) }
+func (b *dynamicFeeBody) priorityFeeCap() *big.Int { return new(big.Int).Set(b.MaxPriorityFeePerGas) }
+
+func (b *dynamicFeeBody) withSignature(sig []byte) txData {
+	cpy := *b
+	cpy.Signature = append([]byte(nil), sig...)
+	return &cpy
+}
+
+func (b *dynamicFeeBody) encodePayload(w io.Writer) error {
+	return rlp.Encode(w, b)
+}
+
+// blobBody is a type-3 tx body modeled on EIP-4844: same fee-market shape
+// as a dynamic-fee tx, but its clauses may declare blob versioned hashes
+// whose raw data travels out-of-band in a Sidecar (see Transaction.Sidecar).
+type blobBody struct {
+	Clauses              []*Clause
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Gas                  uint64
+	Nonce                uint64
+	TimeBarrier          uint64
+	DependsOn            *thor.Hash `rlp:"nil"`
+	Signature            []byte
+
+	// Expiration is the number of seconds after TimeBarrier during which
+	// the tx is valid; 0 means it never expires. Optional for back-compat.
+	Expiration uint64 `rlp:"optional"`
+}
+
+func (b *blobBody) txType() byte          { return BlobTxType }
+func (b *blobBody) clauses() []*Clause    { return b.Clauses }
+func (b *blobBody) gas() uint64           { return b.Gas }
+func (b *blobBody) nonce() uint64         { return b.Nonce }
+func (b *blobBody) timeBarrier() uint64   { return b.TimeBarrier }
+func (b *blobBody) expiration() uint64    { return b.Expiration }
+func (b *blobBody) dependsOn() *thor.Hash { return b.DependsOn }
+func (b *blobBody) signature() []byte     { return b.Signature }
+
+func (b *blobBody) gasPrice(baseFee *big.Int) *big.Int {
+	tip := new(big.Int).Sub(b.MaxFeePerGas, baseFee)
+	if tip.Cmp(b.MaxPriorityFeePerGas) > 0 {
+		tip = b.MaxPriorityFeePerGas
+	}
+	return new(big.Int).Add(baseFee, tip)
+}
+
+func (b *blobBody) feeCap() *big.Int         { return new(big.Int).Set(b.MaxFeePerGas) }
+func (b *blobBody) priorityFeeCap() *big.Int { return new(big.Int).Set(b.MaxPriorityFeePerGas) }
+
+func (b *blobBody) withSignature(sig []byte) txData {
+	cpy := *b
+	cpy.Signature = append([]byte(nil), sig...)
+	return &cpy
+}
+
+func (b *blobBody) encodePayload(w io.Writer) error {
+	return rlp.Encode(w, b)
+}
+
+// Type returns the tx's envelope type, e.g. LegacyTxType or DynamicFeeTxType.
+func (t *Transaction) Type() byte {
+	return t.inner.txType()
 }
 
 // Hash returns hash of tx.
@@ -50,99 +229,312 @@ func (t *Transaction) Hash() (hash thor.Hash) {
 
 // SigningHash returns hash of tx excludes signature.
 func (t *Transaction) SigningHash() (hash thor.Hash) {
+	if cached := t.cache.signingHash; cached != nil {
+		return *cached
+	}
+
 	hw := cry.NewHasher()
-	rlp.Encode(hw, []interface{}{
-		t.body.Clauses,
-		t.body.GasPrice,
-		t.body.Gas,
-		t.body.Nonce,
-		t.body.TimeBarrier,
-		t.body.DependsOn,
-	})
+	switch body := t.inner.(type) {
+	case *legacyBody:
+		// A legacy tx signed before Expiration existed was signed over a
+		// 6-element list. Only append Expiration once it's actually set,
+		// so every pre-existing signature/SigningHash still matches;
+		// Expiration's own doc comment promises this back-compat.
+		if body.Expiration == 0 {
+			rlp.Encode(hw, []interface{}{
+				body.Clauses,
+				body.GasPrice,
+				body.Gas,
+				body.Nonce,
+				body.TimeBarrier,
+				body.DependsOn,
+			})
+		} else {
+			rlp.Encode(hw, []interface{}{
+				body.Clauses,
+				body.GasPrice,
+				body.Gas,
+				body.Nonce,
+				body.TimeBarrier,
+				body.DependsOn,
+				body.Expiration,
+			})
+		}
+	case *dynamicFeeBody:
+		rlp.Encode(hw, []interface{}{
+			body.txType(),
+			body.Clauses,
+			body.MaxFeePerGas,
+			body.MaxPriorityFeePerGas,
+			body.Gas,
+			body.Nonce,
+			body.TimeBarrier,
+			body.DependsOn,
+			body.Expiration,
+		})
+	case *blobBody:
+		rlp.Encode(hw, []interface{}{
+			body.txType(),
+			body.Clauses,
+			body.MaxFeePerGas,
+			body.MaxPriorityFeePerGas,
+			body.Gas,
+			body.Nonce,
+			body.TimeBarrier,
+			body.DependsOn,
+			body.Expiration,
+		})
+	}
 	hw.Sum(hash[:0])
-	return
+	t.cache.signingHash = &hash
+	return hash
 }
 
-// GasPrice returns gas price.
+// GasPrice returns the gas price of a legacy tx, or the fee cap
+// (MaxFeePerGas) of a fee-market tx (dynamic-fee or blob). Use
+// EffectiveGasPrice for the price actually charged to the payer.
 func (t *Transaction) GasPrice() *big.Int {
-	return new(big.Int).Set(t.body.GasPrice)
+	if feeCap := t.inner.feeCap(); feeCap != nil {
+		return new(big.Int).Set(feeCap)
+	}
+	return t.inner.gasPrice(nil)
+}
+
+// MaxFeePerGas returns the fee cap of a fee-market tx (dynamic-fee or
+// blob), or nil for a legacy tx.
+func (t *Transaction) MaxFeePerGas() *big.Int {
+	if feeCap := t.inner.feeCap(); feeCap != nil {
+		return new(big.Int).Set(feeCap)
+	}
+	return nil
+}
+
+// MaxPriorityFeePerGas returns the priority tip cap of a fee-market tx
+// (dynamic-fee or blob), or nil for a legacy tx.
+func (t *Transaction) MaxPriorityFeePerGas() *big.Int {
+	if tip := t.inner.priorityFeeCap(); tip != nil {
+		return new(big.Int).Set(tip)
+	}
+	return nil
+}
+
+// EffectiveGasPrice returns the price per unit of gas the payer is
+// actually charged when packed against the given block base fee. For a
+// legacy tx, baseFee is ignored and GasPrice is returned unchanged.
+func (t *Transaction) EffectiveGasPrice(baseFee *big.Int) *big.Int {
+	return t.inner.gasPrice(baseFee)
+}
+
+// CheckFeeCap reports an error if this is a fee-market tx (dynamic-fee or
+// blob) whose fee cap is below baseFee. Block validation must reject such
+// txs rather than packing them.
+func (t *Transaction) CheckFeeCap(baseFee *big.Int) error {
+	feeCap := t.inner.feeCap()
+	if feeCap == nil {
+		return nil
+	}
+	if feeCap.Cmp(baseFee) < 0 {
+		return fmt.Errorf("tx: max fee per gas (%v) below base fee (%v)", feeCap, baseFee)
+	}
+	return nil
+}
+
+// FeeCapRefund returns the amount to refund the payer for gas actually
+// used: the difference between the fee cap and the price actually
+// charged (baseFee+tip), times gasUsed. It's zero for a legacy tx.
+func (t *Transaction) FeeCapRefund(baseFee *big.Int, gasUsed uint64) *big.Int {
+	feeCap := t.inner.feeCap()
+	if feeCap == nil {
+		return new(big.Int)
+	}
+	charged := t.inner.gasPrice(baseFee)
+	diff := new(big.Int).Sub(feeCap, charged)
+	return diff.Mul(diff, new(big.Int).SetUint64(gasUsed))
 }
 
 // Gas returns gas provision for this tx.
 func (t *Transaction) Gas() uint64 {
-	return t.body.Gas
+	return t.inner.gas()
 }
 
 // TimeBarrier returns time barrier.
 // It's required that tx.TimeBarrier <= block.Timestamp,
 // when a tx was packed in a block.
 func (t *Transaction) TimeBarrier() uint64 {
-	return t.body.TimeBarrier
+	return t.inner.timeBarrier()
+}
+
+// Expiration returns the number of seconds after TimeBarrier during which
+// the tx remains valid for block inclusion, or 0 if it never expires.
+func (t *Transaction) Expiration() uint64 {
+	return t.inner.expiration()
+}
+
+// IsExpired reports whether the tx has outlived its Expiration window,
+// given the timestamp of the block it would be packed into.
+func (t *Transaction) IsExpired(headTimestamp uint64) bool {
+	exp := t.inner.expiration()
+	if exp == 0 {
+		return false
+	}
+	return headTimestamp > t.inner.timeBarrier()+exp
 }
 
 // NewClauseIterator create a clause iteartor.
 // It returns a function acts as 'Next'.
 func (t *Transaction) NewClauseIterator() func() (clause *Clause, index int, ok bool) {
+	clauses := t.inner.clauses()
 	i := 0
 	return func() (c *Clause, index int, ok bool) {
-		if i >= len(t.body.Clauses) {
+		if i >= len(clauses) {
 			return nil, 0, false
 		}
-		c, index, ok = t.body.Clauses[i], i, true
+		c, index, ok = clauses[i], i, true
 		i++
 		return
 	}
 }
 
+// AccessList returns the access lists declared across all clauses, in
+// clause order. Before executing the tx, the runtime should mark every
+// listed address and storage key "warm", since their upfront cost was
+// already charged via IntrinsicGas.
+func (t *Transaction) AccessList() []AccessTuple {
+	var list []AccessTuple
+	for _, c := range t.inner.clauses() {
+		list = append(list, c.body.AccessList...)
+	}
+	return list
+}
+
 // ClauseCount returns count of clauses contained in this tx.
 func (t *Transaction) ClauseCount() int {
-	return len(t.body.Clauses)
+	return len(t.inner.clauses())
 }
 
 // Signature returns signature.
 func (t *Transaction) Signature() []byte {
-	return append([]byte(nil), t.body.Signature...)
+	return append([]byte(nil), t.inner.signature()...)
 }
 
 // WithSignature create a new tx with signature set.
 func (t *Transaction) WithSignature(sig []byte) *Transaction {
-	newTx := Transaction{
-		body: t.body,
+	return &Transaction{
+		inner:   t.inner.withSignature(sig),
+		sidecar: t.sidecar,
 	}
-	// copy sig
-	newTx.body.Signature = append([]byte(nil), sig...)
-	return &newTx
 }
 
-// EncodeRLP implements rlp.Encoder
+// WithSidecar returns a shallow copy of the tx carrying the given blob
+// sidecar. The sidecar is never part of Hash/SigningHash or of the
+// canonical RLP produced by EncodeRLP: it travels out-of-band, attached
+// only for gossip between nodes (see block.Block.NetworkEncodeRLP).
+func (t *Transaction) WithSidecar(sc *Sidecar) *Transaction {
+	cpy := *t
+	cpy.sidecar = sc
+	return &cpy
+}
+
+// Sidecar returns the tx's blob sidecar, or nil if it doesn't carry one.
+func (t *Transaction) Sidecar() *Sidecar {
+	return t.sidecar
+}
+
+// VerifyBlobs verifies the tx's sidecar, if any, against the blob
+// versioned hashes declared by its clauses.
+func (t *Transaction) VerifyBlobs() error {
+	if t.sidecar == nil {
+		return nil
+	}
+	var declared []thor.Hash
+	for _, c := range t.inner.clauses() {
+		declared = append(declared, c.body.BlobHashes...)
+	}
+	return t.sidecar.VerifyBlobs(declared)
+}
+
+// EncodeRLP implements rlp.Encoder. A legacy tx is encoded as before, a
+// plain RLP list; a typed tx is encoded as an RLP string of
+// [TypeByte, payload-rlp...] so it nests into tx lists as a single item.
 func (t *Transaction) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, &t.body)
+	if t.inner.txType() == LegacyTxType {
+		return t.inner.encodePayload(w)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte(t.inner.txType())
+	if err := t.inner.encodePayload(&buf); err != nil {
+		return err
+	}
+	return rlp.Encode(w, buf.Bytes())
 }
 
-// DecodeRLP implements rlp.Decoder
+// DecodeRLP implements rlp.Decoder. It dispatches on the envelope's kind:
+// an RLP list decodes as a legacy tx, an RLP string decodes as
+// [TypeByte, payload-rlp...] for a typed tx.
 func (t *Transaction) DecodeRLP(s *rlp.Stream) error {
-	var body body
-	if err := s.Decode(&body); err != nil {
+	kind, _, err := s.Kind()
+	if err != nil {
+		return err
+	}
+
+	if kind == rlp.List {
+		var body legacyBody
+		if err := s.Decode(&body); err != nil {
+			return err
+		}
+		*t = Transaction{inner: &body}
+		return nil
+	}
+
+	raw, err := s.Bytes()
+	if err != nil {
 		return err
 	}
-	*t = Transaction{
-		body: body,
+	if len(raw) == 0 {
+		return errors.New("tx: empty typed tx envelope")
 	}
+
+	inner, err := decodeTypedBody(raw[0], raw[1:])
+	if err != nil {
+		return err
+	}
+	*t = Transaction{inner: inner}
 	return nil
 }
 
+func decodeTypedBody(txType byte, payload []byte) (txData, error) {
+	switch txType {
+	case DynamicFeeTxType:
+		var body dynamicFeeBody
+		if err := rlp.DecodeBytes(payload, &body); err != nil {
+			return nil, err
+		}
+		return &body, nil
+	case BlobTxType:
+		var body blobBody
+		if err := rlp.DecodeBytes(payload, &body); err != nil {
+			return nil, err
+		}
+		return &body, nil
+	default:
+		return nil, fmt.Errorf("tx: unsupported tx type %d", txType)
+	}
+}
+
 // IntrinsicGas returns intrinsic gas of tx.
 // That's sum of all clauses intrinsic gas.
 func (t *Transaction) IntrinsicGas() (uint64, error) {
-	clauseCount := len(t.body.Clauses)
+	clauses := t.inner.clauses()
+	clauseCount := len(clauses)
 	if clauseCount == 0 {
 		return params.TxGas, nil
 	}
 
-	firstClause := t.body.Clauses[0]
+	firstClause := clauses[0]
 	total := core.IntrinsicGas(firstClause.body.Data, firstClause.body.To == nil, true)
 
-	for _, c := range t.body.Clauses[1:] {
+	for _, c := range clauses[1:] {
 		contractCreation := c.body.To == nil
 		total.Add(total, core.IntrinsicGas(c.body.Data, contractCreation, true))
 
@@ -154,6 +546,14 @@ func (t *Transaction) IntrinsicGas() (uint64, error) {
 		}
 	}
 
+	// charge for any pre-declared access lists, mirroring EIP-2930.
+	for _, c := range clauses {
+		for _, al := range c.body.AccessList {
+			total.Add(total, new(big.Int).SetUint64(params.TxAccessListAddressGas))
+			total.Add(total, new(big.Int).SetUint64(params.TxAccessListStorageKeyGas*uint64(len(al.StorageKeys))))
+		}
+	}
+
 	if total.BitLen() > 64 {
 		return 0, errors.New("intrinsic gas too large")
 	}
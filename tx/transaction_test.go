@@ -0,0 +1,185 @@
+package tx
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/vechain/thor/cry"
+	"github.com/vechain/thor/thor"
+)
+
+func newBlobTx(feeCap, tip int64) *Transaction {
+	return &Transaction{
+		inner: &blobBody{
+			Clauses:              []*Clause{NewClause(nil)},
+			MaxFeePerGas:         big.NewInt(feeCap),
+			MaxPriorityFeePerGas: big.NewInt(tip),
+			Gas:                  21000,
+			Nonce:                1,
+			TimeBarrier:          100,
+			Signature:            []byte{1, 2, 3},
+		},
+	}
+}
+
+// GasPrice used to type-assert only *dynamicFeeBody, so calling it on a
+// blob tx fell through to blobBody.gasPrice(nil) and panicked on the nil
+// baseFee. It must instead return the fee cap, like it does for
+// dynamic-fee txs.
+func TestBlobBodyGasPriceDoesNotPanic(t *testing.T) {
+	tx := newBlobTx(100, 10)
+
+	got := tx.GasPrice()
+	want := big.NewInt(100)
+	if got.Cmp(want) != 0 {
+		t.Fatalf("GasPrice() = %v, want %v", got, want)
+	}
+}
+
+func TestBlobBodyFeeCapAccessors(t *testing.T) {
+	tx := newBlobTx(100, 10)
+
+	if got, want := tx.MaxFeePerGas(), big.NewInt(100); got == nil || got.Cmp(want) != 0 {
+		t.Fatalf("MaxFeePerGas() = %v, want %v", got, want)
+	}
+	if got, want := tx.MaxPriorityFeePerGas(), big.NewInt(10); got == nil || got.Cmp(want) != 0 {
+		t.Fatalf("MaxPriorityFeePerGas() = %v, want %v", got, want)
+	}
+}
+
+func TestBlobBodyCheckFeeCap(t *testing.T) {
+	tx := newBlobTx(100, 10)
+
+	if err := tx.CheckFeeCap(big.NewInt(50)); err != nil {
+		t.Fatalf("CheckFeeCap(50) = %v, want nil", err)
+	}
+	if err := tx.CheckFeeCap(big.NewInt(200)); err == nil {
+		t.Fatal("CheckFeeCap(200) = nil, want error")
+	}
+}
+
+func TestBlobBodyFeeCapRefund(t *testing.T) {
+	tx := newBlobTx(100, 10)
+
+	// charged = min(feeCap, baseFee+tip) = min(100, 50+10) = 60
+	// refund per unit gas = feeCap - charged = 40
+	refund := tx.FeeCapRefund(big.NewInt(50), 21000)
+	want := big.NewInt(40 * 21000)
+	if refund.Cmp(want) != 0 {
+		t.Fatalf("FeeCapRefund() = %v, want %v", refund, want)
+	}
+}
+
+// A legacy tx with Expiration == 0 must hash over the original 6-element
+// list, exactly as it did before Expiration existed, so pre-existing
+// signatures still verify. Only a tx that actually sets Expiration may
+// hash over the extended 7-element list.
+func TestLegacyBodySigningHashBackCompat(t *testing.T) {
+	withoutExpiration := new(Builder).
+		Clause(NewClause(nil)).
+		Gas(21000).
+		GasPrice(big.NewInt(100)).
+		Nonce(1).
+		TimeBarrier(100).
+		Build()
+
+	withExpiration := new(Builder).
+		Clause(NewClause(nil)).
+		Gas(21000).
+		GasPrice(big.NewInt(100)).
+		Nonce(1).
+		TimeBarrier(100).
+		Expiration(50).
+		Build()
+
+	if withoutExpiration.SigningHash() != preExpirationSigningHash(withoutExpiration) {
+		t.Fatal("SigningHash() with Expiration == 0 must match the pre-Expiration 6-element hash")
+	}
+	if withExpiration.SigningHash() == withoutExpiration.SigningHash() {
+		t.Fatal("SigningHash() must differ once Expiration is actually set")
+	}
+}
+
+// preExpirationSigningHash recomputes the hash the way SigningHash did
+// before Expiration was introduced, as the independent oracle for the
+// back-compat assertion above.
+func preExpirationSigningHash(t *Transaction) (hash thor.Hash) {
+	body := t.inner.(*legacyBody)
+	hw := cry.NewHasher()
+	rlp.Encode(hw, []interface{}{
+		body.Clauses,
+		body.GasPrice,
+		body.Gas,
+		body.Nonce,
+		body.TimeBarrier,
+		body.DependsOn,
+	})
+	hw.Sum(hash[:0])
+	return hash
+}
+
+func TestBlobBodyRLPRoundTrip(t *testing.T) {
+	hash := thor.Hash{1, 2, 3}
+	clause := NewClause(nil).WithBlobHashes([]thor.Hash{hash})
+
+	orig := &Transaction{
+		inner: &blobBody{
+			Clauses:              []*Clause{clause},
+			MaxFeePerGas:         big.NewInt(100),
+			MaxPriorityFeePerGas: big.NewInt(10),
+			Gas:                  21000,
+			Nonce:                1,
+			TimeBarrier:          100,
+			Signature:            []byte{1, 2, 3},
+		},
+	}
+
+	data, err := rlp.EncodeToBytes(orig)
+	if err != nil {
+		t.Fatalf("EncodeToBytes() = %v", err)
+	}
+
+	var decoded Transaction
+	if err := rlp.DecodeBytes(data, &decoded); err != nil {
+		t.Fatalf("DecodeBytes() = %v", err)
+	}
+
+	if decoded.Type() != BlobTxType {
+		t.Fatalf("Type() = %v, want %v", decoded.Type(), BlobTxType)
+	}
+	if got, want := decoded.MaxFeePerGas(), orig.MaxFeePerGas(); got.Cmp(want) != 0 {
+		t.Fatalf("MaxFeePerGas() = %v, want %v", got, want)
+	}
+	if decoded.Hash() != orig.Hash() {
+		t.Fatal("decoded tx hash does not match original")
+	}
+}
+
+// A blob tx must be buildable through the public Builder API, same as
+// legacy and dynamic-fee txs, not only by hand-assembling blobBody from
+// inside this package.
+func TestBuilderBlobTx(t *testing.T) {
+	hash := thor.Hash{1, 2, 3}
+	clause := NewClause(nil).WithBlobHashes([]thor.Hash{hash})
+
+	built := new(Builder).
+		Clause(clause).
+		Gas(21000).
+		MaxFeePerGas(big.NewInt(100)).
+		MaxPriorityFeePerGas(big.NewInt(10)).
+		BlobTx().
+		Build().
+		WithSignature([]byte{1, 2, 3}).
+		WithSidecar(&Sidecar{})
+
+	if got := built.Type(); got != BlobTxType {
+		t.Fatalf("Type() = %v, want %v", got, BlobTxType)
+	}
+	if got, want := built.MaxFeePerGas(), big.NewInt(100); got.Cmp(want) != 0 {
+		t.Fatalf("MaxFeePerGas() = %v, want %v", got, want)
+	}
+	if built.Sidecar() == nil {
+		t.Fatal("Sidecar() = nil, want the attached sidecar")
+	}
+}
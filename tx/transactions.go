@@ -0,0 +1,9 @@
+package tx
+
+// Transactions is a slice of transactions.
+type Transactions []*Transaction
+
+// Len returns count of transactions.
+func (ts Transactions) Len() int {
+	return len(ts)
+}